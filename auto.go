@@ -0,0 +1,256 @@
+package scan
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// AutoOption configures the behaviour of Auto.
+type AutoOption func(*autoConfig)
+
+type autoConfig struct {
+	tag string
+}
+
+// WithTag sets the struct tag name Auto reads to find column names. Defaults to "db".
+func WithTag(tag string) AutoOption {
+	return func(c *autoConfig) {
+		c.tag = tag
+	}
+}
+
+type autoKind int
+
+const (
+	autoKindPlain autoKind = iota
+	autoKindJSON
+	autoKindNull
+)
+
+type autoField struct {
+	index []int
+	kind  autoKind
+	def   string
+}
+
+type autoCacheKey struct {
+	typ reflect.Type
+	tag string
+}
+
+// autoCache memoizes the struct-tag walk per type and tag name, so repeated
+// calls to Auto don't re-walk struct fields via reflection.
+var autoCache sync.Map //nolint:gochecknoglobals
+
+// Auto builds a Columns[T] map by walking T's exported fields via reflection
+// and reading tags such as `db:"column_name"`, `db:"column_name,json"`,
+// `db:"column_name,null=default"`, and `db:"prefix.*"` on embedded structs.
+// The derived field plan is cached per struct type, so the reflection walk
+// only happens once.
+func Auto[T any](opts ...AutoOption) Columns[T] {
+	cfg := autoConfig{tag: "db"}
+
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	typ := reflect.TypeFor[T]()
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	key := autoCacheKey{typ: typ, tag: cfg.tag}
+
+	fields, ok := autoCache.Load(key)
+	if !ok {
+		built := map[string]autoField{}
+		walkAutoFields(typ, cfg.tag, nil, "", built)
+		fields, _ = autoCache.LoadOrStore(key, built)
+	}
+
+	columns := make(Columns[T], len(fields.(map[string]autoField)))
+
+	for name, field := range fields.(map[string]autoField) {
+		columns[name] = autoScanner[T]{field: field}
+	}
+
+	return columns
+}
+
+func walkAutoFields(typ reflect.Type, tag string, index []int, prefix string, out map[string]autoField) {
+	for i := 0; i < typ.NumField(); i++ {
+		structField := typ.Field(i)
+		if structField.PkgPath != "" {
+			continue
+		}
+
+		value := structField.Tag.Get(tag)
+
+		fieldIndex := make([]int, len(index)+1)
+		copy(fieldIndex, index)
+		fieldIndex[len(index)] = i
+
+		if structField.Anonymous && (value == "" || strings.HasSuffix(value, ".*")) {
+			embeddedType := structField.Type
+			for embeddedType.Kind() == reflect.Pointer {
+				embeddedType = embeddedType.Elem()
+			}
+
+			if embeddedType.Kind() == reflect.Struct {
+				embeddedPrefix := prefix
+				if strings.HasSuffix(value, ".*") {
+					embeddedPrefix += strings.TrimSuffix(value, "*")
+				}
+
+				walkAutoFields(embeddedType, tag, fieldIndex, embeddedPrefix, out)
+
+				continue
+			}
+		}
+
+		if value == "" || value == "-" {
+			continue
+		}
+
+		parts := strings.Split(value, ",")
+
+		field := autoField{index: fieldIndex}
+
+		for _, opt := range parts[1:] {
+			switch {
+			case opt == "json":
+				field.kind = autoKindJSON
+			case strings.HasPrefix(opt, "null"):
+				field.kind = autoKindNull
+
+				if eq := strings.IndexByte(opt, '='); eq >= 0 {
+					field.def = opt[eq+1:]
+				}
+			}
+		}
+
+		out[prefix+parts[0]] = field
+	}
+}
+
+// autoScanner implements Scanner[T] for a single reflection-derived field.
+type autoScanner[T any] struct {
+	field autoField
+}
+
+func (s autoScanner[T]) Scan() (any, func(*T) error) {
+	switch s.field.kind {
+	case autoKindJSON:
+		var b []byte
+
+		return &b, func(t *T) error {
+			if b == nil {
+				return nil
+			}
+
+			fv := fieldByIndex(reflect.ValueOf(t).Elem(), s.field.index)
+
+			return json.Unmarshal(b, fv.Addr().Interface())
+		}
+	case autoKindNull:
+		var v any
+
+		return &v, func(t *T) error {
+			fv := fieldByIndex(reflect.ValueOf(t).Elem(), s.field.index)
+			if v == nil {
+				return assignDefault(fv, s.field.def)
+			}
+
+			return assignField(fv, v)
+		}
+	default:
+		var v any
+
+		return &v, func(t *T) error {
+			return assignField(fieldByIndex(reflect.ValueOf(t).Elem(), s.field.index), v)
+		}
+	}
+}
+
+// fieldByIndex walks nested/embedded fields, allocating intermediate
+// pointers for embedded pointer structs along the way.
+func fieldByIndex(v reflect.Value, index []int) reflect.Value {
+	for _, i := range index {
+		if v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+
+			v = v.Elem()
+		}
+
+		v = v.Field(i)
+	}
+
+	return v
+}
+
+// assignField sets fv to value, converting between the driver value's type
+// and the struct field's type where possible. When value is neither
+// assignable nor convertible to fv's type, it falls back to the converter
+// registered for fv's type via RegisterConverter, if any, e.g. to turn a
+// NUMBER string or a []byte UUID into the field's type.
+func assignField(fv reflect.Value, value any) error {
+	if value == nil {
+		return nil
+	}
+
+	rv := reflect.ValueOf(value)
+
+	switch {
+	case rv.Type().AssignableTo(fv.Type()):
+		fv.Set(rv)
+
+		return nil
+	case rv.Type().ConvertibleTo(fv.Type()):
+		fv.Set(rv.Convert(fv.Type()))
+
+		return nil
+	}
+
+	converted, ok, err := convertValue(fv.Type(), value)
+	if err != nil {
+		return err
+	}
+
+	if ok {
+		fv.Set(reflect.ValueOf(converted))
+
+		return nil
+	}
+
+	return fmt.Errorf("scan: cannot assign %T to field of type %s", value, fv.Type())
+}
+
+// assignDefault sets fv to def. String fields take def verbatim, including
+// any spaces; every other kind is parsed with fmt.Sscan, which only reads a
+// single whitespace-delimited token.
+func assignDefault(fv reflect.Value, def string) error {
+	if def == "" {
+		return nil
+	}
+
+	if fv.Kind() == reflect.String {
+		fv.SetString(def)
+
+		return nil
+	}
+
+	target := reflect.New(fv.Type())
+
+	if _, err := fmt.Sscan(def, target.Interface()); err != nil {
+		return fmt.Errorf("scan: invalid default %q: %w", def, err)
+	}
+
+	fv.Set(target.Elem())
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package scan
+
+import (
+	"reflect"
+	"testing"
+)
+
+type autoCacheStruct struct {
+	ID int64 `db:"id"`
+}
+
+// TestAutoCachesFieldWalk asserts that a second Auto[T]() call reuses the
+// field plan built by the first call instead of re-walking T's fields.
+func TestAutoCachesFieldWalk(t *testing.T) {
+	_ = Auto[autoCacheStruct]()
+
+	key := autoCacheKey{typ: reflect.TypeOf(autoCacheStruct{}), tag: "db"}
+
+	first, ok := autoCache.Load(key)
+	if !ok {
+		t.Fatal("expected a cache entry after the first Auto call")
+	}
+
+	_ = Auto[autoCacheStruct]()
+
+	second, ok := autoCache.Load(key)
+	if !ok {
+		t.Fatal("expected a cache entry after the second Auto call")
+	}
+
+	if reflect.ValueOf(first).Pointer() != reflect.ValueOf(second).Pointer() {
+		t.Fatal("second Auto call re-walked the struct fields instead of reusing the cached plan")
+	}
+}
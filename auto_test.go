@@ -0,0 +1,91 @@
+//nolint:gocritic,depguard,gochecknoglobals,exhaustivestruct,exhaustruct
+package scan_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/wroge/scan"
+)
+
+type AutoFlat struct {
+	ID    int64  `db:"id"`
+	Title string `db:"title"`
+}
+
+type AutoMeta struct {
+	CreatedAt string `db:"created_at"`
+}
+
+type AutoArticle struct {
+	ID       int64    `db:"id"`
+	Title    string   `db:"title,null=No Title"`
+	Tags     []string `db:"tags,json"`
+	AutoMeta `db:"meta.*"`
+}
+
+func TestAutoFlat(t *testing.T) {
+	t.Parallel()
+
+	rows := &fakeRows{
+		index:   -1,
+		columns: []string{"id", "title"},
+		data: [][]any{
+			{1, "Post One"},
+		},
+	}
+
+	post, err := scan.One[AutoFlat](rows, scan.Auto[AutoFlat]())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if fmt.Sprint(post) != `{1 Post One}` {
+		t.Fatal(post)
+	}
+}
+
+func TestAutoEmbeddedJSONAndNullDefault(t *testing.T) {
+	t.Parallel()
+
+	rows := &fakeRows{
+		index:   -1,
+		columns: []string{"id", "title", "tags", "meta.created_at"},
+		data: [][]any{
+			{1, nil, []byte(`["go","sql"]`), "2024-01-01"},
+		},
+	}
+
+	article, err := scan.One[AutoArticle](rows, scan.Auto[AutoArticle]())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if fmt.Sprint(article) != `{1 No Title [go sql] {2024-01-01}}` {
+		t.Fatal(article)
+	}
+}
+
+// TestAutoNullDefaultWithSpaces guards against assignDefault truncating a
+// multi-word null= default at the first space (fmt.Sscan only reads one
+// whitespace-delimited token).
+func TestAutoNullDefaultWithSpaces(t *testing.T) {
+	t.Parallel()
+
+	rows := &fakeRows{
+		index:   -1,
+		columns: []string{"id", "title", "tags", "meta.created_at"},
+		data: [][]any{
+			{1, nil, []byte(`[]`), ""},
+		},
+	}
+
+	article, err := scan.One[AutoArticle](rows, scan.Auto[AutoArticle]())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if article.Title != "No Title" {
+		t.Fatal(article.Title)
+	}
+}
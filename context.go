@@ -0,0 +1,164 @@
+package scan
+
+import (
+	"context"
+	"errors"
+	"iter"
+)
+
+// AllContext retrieves all rows like All, but checks ctx between rows and
+// closes Rows promptly once ctx is done. As with All, a row appends its
+// zero-value placeholder to the returned slice before it is scanned, so on a
+// mid-scan error the returned slice still has one element per row seen so
+// far, the last of which is only partially populated.
+func AllContext[T any](ctx context.Context, rows Rows, columns Columns[T]) ([]T, error) {
+	it, err := Iter(rows, columns)
+	if err != nil {
+		return nil, err
+	}
+
+	var list []T
+
+	for it.Next() {
+		if err = ctx.Err(); err != nil {
+			return list, errors.Join(err, it.Err(), it.Close())
+		}
+
+		list = append(list, *new(T))
+
+		if err = it.Scan(&list[len(list)-1]); err != nil {
+			return list, errors.Join(err, it.Err(), it.Close())
+		}
+	}
+
+	return list, errors.Join(it.Err(), it.Close())
+}
+
+// LimitContext retrieves up to limit rows like Limit, but checks ctx between
+// rows and closes Rows promptly once ctx is done. As soon as limit rows have
+// been scanned, it stops without consuming any further rows from Rows.
+func LimitContext[T any](ctx context.Context, limit int, rows Rows, columns Columns[T]) ([]T, error) {
+	it, err := Iter(rows, columns)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]T, 0, limit)
+
+	for len(list) < limit && it.Next() {
+		if err = ctx.Err(); err != nil {
+			return list, errors.Join(err, it.Err(), it.Close())
+		}
+
+		list = append(list, *new(T))
+
+		if err = it.Scan(&list[len(list)-1]); err != nil {
+			return list, errors.Join(err, it.Err(), it.Close())
+		}
+	}
+
+	return list, errors.Join(it.Err(), it.Close())
+}
+
+// Stream scans rows in the background, sending each value on the returned
+// channel and any error on the error channel. Both channels are closed, and
+// Rows is closed, once iteration ends, an error occurs, or ctx is done.
+func Stream[T any](ctx context.Context, rows Rows, columns Columns[T]) (<-chan T, <-chan error) {
+	values := make(chan T)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(values)
+		defer close(errs)
+
+		it, err := Iter(rows, columns)
+		if err != nil {
+			errs <- err
+
+			return
+		}
+		defer it.Close()
+
+		for it.Next() {
+			if err = ctx.Err(); err != nil {
+				errs <- err
+
+				return
+			}
+
+			t, err := it.Value()
+			if err != nil {
+				errs <- errors.Join(err, it.Err())
+
+				return
+			}
+
+			select {
+			case values <- t:
+			case <-ctx.Done():
+				errs <- ctx.Err()
+
+				return
+			}
+		}
+
+		if err = it.Err(); err != nil {
+			errs <- err
+		}
+	}()
+
+	return values, errs
+}
+
+// Chunks scans rows in fixed-size batches, yielding each batch and any
+// error encountered while filling it. It is the natural building block for
+// ETL-style consumers that pipe scanned rows into downstream sinks, e.g.
+// bulk inserts or message queues.
+func Chunks[T any](ctx context.Context, size int, rows Rows, columns Columns[T]) iter.Seq2[[]T, error] {
+	return func(yield func([]T, error) bool) {
+		it, err := Iter(rows, columns)
+		if err != nil {
+			yield(nil, err)
+
+			return
+		}
+		defer it.Close()
+
+		batch := make([]T, 0, size)
+
+		for it.Next() {
+			if err = ctx.Err(); err != nil {
+				yield(batch, err)
+
+				return
+			}
+
+			t, err := it.Value()
+			if err != nil {
+				yield(batch, err)
+
+				return
+			}
+
+			batch = append(batch, t)
+
+			if len(batch) == size {
+				if !yield(batch, nil) {
+					return
+				}
+
+				batch = make([]T, 0, size)
+			}
+		}
+
+		if err = it.Err(); err != nil {
+			yield(batch, err)
+
+			return
+		}
+
+		if len(batch) > 0 {
+			yield(batch, nil)
+		}
+	}
+}
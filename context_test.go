@@ -0,0 +1,123 @@
+//nolint:gocritic,depguard,gochecknoglobals,exhaustivestruct,exhaustruct
+package scan_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/wroge/scan"
+)
+
+func TestAllContext(t *testing.T) {
+	t.Parallel()
+
+	posts, err := scan.AllContext[Post](context.Background(), rows1(), columns1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if fmt.Sprint(posts) != `[{1 No Title [{1 Jim} {2 Tim}]} {2 Post Two [{2 Tim}]}`+
+		` {3 Post Three [{2 Tim} {3 Tom}]} {4 Post Four [{1 Jim} {2 Tim}]}`+
+		` {5 Post Five [{1 Jim} {3 Tom}]} {6 Post Six [{2 Tim}]} {7 Post Seven [{3 Tom}]}`+
+		` {8 Post Eight [{1 Jim}]} {9 Post Nine [{1 Jim} {2 Tim} {3 Tom}]} {10 Post Ten [{3 Tom}]}]` {
+		t.Fatal(posts)
+	}
+}
+
+func TestAllContextScanError(t *testing.T) {
+	t.Parallel()
+
+	// rows4 has a single row and fails on Scan, matching TestAllError2's
+	// fixture. All (and thus AllContext) appends a row's zero-value
+	// placeholder before scanning into it, so the returned slice still has
+	// one partially-populated element even though the scan failed.
+	list, err := scan.AllContext[Post](context.Background(), rows4(), columns1)
+	if err == nil {
+		t.Fatal("error is nil")
+	}
+
+	if len(list) != 1 {
+		t.Fatal(list)
+	}
+}
+
+func TestAllContextCancelled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := scan.AllContext[Post](ctx, rows1(), columns1)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatal(err)
+	}
+}
+
+func TestLimitContext(t *testing.T) {
+	t.Parallel()
+
+	posts, err := scan.LimitContext[Post](context.Background(), 1, rows1(), columns1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if fmt.Sprint(posts) != `[{1 No Title [{1 Jim} {2 Tim}]}]` {
+		t.Fatal(posts)
+	}
+}
+
+func TestLimitContextCancelled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := scan.LimitContext[Post](ctx, 10, rows1(), columns1)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatal(err)
+	}
+}
+
+func TestStream(t *testing.T) {
+	t.Parallel()
+
+	values, errs := scan.Stream[Post](context.Background(), rows1(), columns1)
+
+	var posts []Post
+
+	for post := range values {
+		posts = append(posts, post)
+	}
+
+	if err := <-errs; err != nil {
+		t.Fatal(err)
+	}
+
+	if len(posts) != 10 {
+		t.Fatal(posts)
+	}
+}
+
+func TestChunks(t *testing.T) {
+	t.Parallel()
+
+	var chunks [][]Post
+
+	for chunk, err := range scan.Chunks[Post](context.Background(), 4, rows1(), columns1) {
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		chunks = append(chunks, chunk)
+	}
+
+	if len(chunks) != 3 {
+		t.Fatal(chunks)
+	}
+
+	if len(chunks[0]) != 4 || len(chunks[1]) != 4 || len(chunks[2]) != 2 {
+		t.Fatal(chunks)
+	}
+}
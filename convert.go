@@ -0,0 +1,70 @@
+package scan
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// converters holds the registered driver-value converters, keyed by the
+// target Go type. It is safe for concurrent reads once registration at
+// startup is complete.
+var converters sync.Map //nolint:gochecknoglobals
+
+// RegisterConverter registers a function that turns the driver.Value payload
+// read from a column into V, e.g. NUMBER strings, []byte UUIDs, or Oracle RAW
+// columns. Registering a converter for V a second time replaces the previous
+// one. RegisterConverter is intended to be called during package
+// initialization, before any concurrent use of Convert.
+func RegisterConverter[V any](conv func(value any) (V, error)) {
+	var v V
+
+	converters.Store(reflect.TypeOf(v), func(value any) (any, error) {
+		return conv(value)
+	})
+}
+
+// Convert creates a scanner for a column whose driver value should be turned
+// into V using the converter previously registered for V via
+// RegisterConverter. The raw driver.Value is requested as any, dispatched
+// through the converter registry, and passed to set.
+//
+// The registry is also consulted automatically by Auto's default scanner: a
+// field scanned by Auto falls back to the registered converter for its type
+// whenever the column's driver value isn't directly assignable or
+// convertible to it, so columns handled by a registered converter don't need
+// a bespoke Convert call.
+func Convert[T, V any](set func(*T, V)) Func[T, any] {
+	return func(t *T, value any) error {
+		var v V
+
+		converted, ok, err := convertValue(reflect.TypeOf(v), value)
+		if err != nil {
+			return err
+		}
+
+		if !ok {
+			return fmt.Errorf("scan: no converter registered for %T", v)
+		}
+
+		set(t, converted.(V))
+
+		return nil
+	}
+}
+
+// convertValue dispatches value through the converter registered for target,
+// if any. ok is false when no converter is registered for target.
+func convertValue(target reflect.Type, value any) (converted any, ok bool, err error) {
+	conv, ok := converters.Load(target)
+	if !ok {
+		return nil, false, nil
+	}
+
+	converted, err = conv.(func(any) (any, error))(value)
+	if err != nil {
+		return nil, true, err
+	}
+
+	return converted, true, nil
+}
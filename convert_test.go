@@ -0,0 +1,158 @@
+//nolint:gocritic,depguard,gochecknoglobals,exhaustivestruct,exhaustruct
+package scan_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/wroge/scan"
+)
+
+type UUID string
+
+type Device struct {
+	ID UUID
+}
+
+func init() {
+	scan.RegisterConverter(func(value any) (UUID, error) {
+		b, ok := value.([]byte)
+		if !ok {
+			return "", fmt.Errorf("scan: expected []byte, got %T", value)
+		}
+
+		return UUID(b), nil
+	})
+}
+
+func deviceRows() *fakeRows {
+	return &fakeRows{
+		index:   -1,
+		columns: []string{"id"},
+		data: [][]any{
+			{[]byte("550e8400")},
+		},
+	}
+}
+
+var deviceColumns = scan.Columns[Device]{
+	"id": scan.Convert(func(device *Device, id UUID) { device.ID = id }),
+}
+
+func TestConvert(t *testing.T) {
+	t.Parallel()
+
+	device, err := scan.One[Device](deviceRows(), deviceColumns)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if device.ID != "550e8400" {
+		t.Fatal(device)
+	}
+}
+
+// NotRegistered has no converter registered for it, exercising Convert's
+// error path when the registry has nothing to dispatch through.
+type NotRegistered string
+
+func TestConvertNoConverterRegistered(t *testing.T) {
+	t.Parallel()
+
+	type Widget struct {
+		Code NotRegistered
+	}
+
+	rows := &fakeRows{
+		index:   -1,
+		columns: []string{"code"},
+		data: [][]any{
+			{[]byte("abc")},
+		},
+	}
+
+	columns := scan.Columns[Widget]{
+		"code": scan.Convert(func(w *Widget, code NotRegistered) { w.Code = code }),
+	}
+
+	if _, err := scan.One[Widget](rows, columns); err == nil {
+		t.Fatal("expected error for unregistered converter")
+	}
+}
+
+// FailingConvert always fails to convert, exercising Convert's handling of
+// a registered converter function that returns an error.
+type FailingConvert string
+
+func init() {
+	scan.RegisterConverter(func(value any) (FailingConvert, error) {
+		return "", fmt.Errorf("scan: cannot convert %v", value)
+	})
+}
+
+func TestConvertConverterError(t *testing.T) {
+	t.Parallel()
+
+	type Widget struct {
+		Code FailingConvert
+	}
+
+	rows := &fakeRows{
+		index:   -1,
+		columns: []string{"code"},
+		data: [][]any{
+			{[]byte("abc")},
+		},
+	}
+
+	columns := scan.Columns[Widget]{
+		"code": scan.Convert(func(w *Widget, code FailingConvert) { w.Code = code }),
+	}
+
+	if _, err := scan.One[Widget](rows, columns); err == nil {
+		t.Fatal("expected error from failing converter")
+	}
+}
+
+// Decimal has no direct assignment/conversion from a string driver value, so
+// scanning it via Auto can only succeed by falling back to its registered
+// converter.
+type Decimal struct {
+	Raw string
+}
+
+func init() {
+	scan.RegisterConverter(func(value any) (Decimal, error) {
+		s, ok := value.(string)
+		if !ok {
+			return Decimal{}, fmt.Errorf("scan: expected string, got %T", value)
+		}
+
+		return Decimal{Raw: s}, nil
+	})
+}
+
+type Price struct {
+	Amount Decimal `db:"amount"`
+}
+
+func TestAutoFallsBackToRegisteredConverter(t *testing.T) {
+	t.Parallel()
+
+	rows := &fakeRows{
+		index:   -1,
+		columns: []string{"amount"},
+		data: [][]any{
+			{"19.99"},
+		},
+	}
+
+	price, err := scan.One[Price](rows, scan.Auto[Price]())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if price.Amount.Raw != "19.99" {
+		t.Fatal(price.Amount)
+	}
+}
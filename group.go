@@ -0,0 +1,98 @@
+package scan
+
+import "errors"
+
+// Group consumes a result set produced by a LEFT JOIN between a parent and a
+// child table and collapses duplicate parent rows into a single Parent with
+// its children appended. key must return a stable, comparable identifier for
+// a scanned Parent (e.g. its primary key); appendChild is called once per row
+// to append the scanned Child onto the matching Parent.
+//
+// A LEFT JOIN also produces rows for parents with no matching child at all,
+// where every child column is NULL. childKey must return nil for such a row
+// and a non-nil identifier otherwise, e.g. the child's primary key; Group
+// skips appendChild whenever childKey returns nil, rather than appending a
+// phantom zero-value Child. A non-nil zero value such as 0 is not treated as
+// missing.
+//
+// This avoids the common workaround of aggregating children into a JSON
+// column: Group scans every joined row directly into a fresh Parent and
+// Child, and a row whose key matches an already-seen parent only appends its
+// Child to that parent instead of adding a new Parent.
+func Group[Parent, Child any](
+	rows Rows,
+	key func(*Parent) any,
+	childKey func(*Child) any,
+	parent Columns[Parent],
+	child Columns[Child],
+	appendChild func(*Parent, Child),
+) ([]Parent, error) {
+	names, err := rows.Columns()
+	if err != nil {
+		return nil, errors.Join(err, rows.Close())
+	}
+
+	var (
+		dest           = make([]any, len(names))
+		parentScanners = make([]func(*Parent) error, len(names))
+		childScanners  = make([]func(*Child) error, len(names))
+	)
+
+	for i, n := range names {
+		switch {
+		case parent[n] != nil:
+			dest[i], parentScanners[i] = parent[n].Scan()
+		case child[n] != nil:
+			dest[i], childScanners[i] = child[n].Scan()
+		default:
+			dest[i] = new(any)
+		}
+	}
+
+	var (
+		list  []Parent
+		index = map[any]int{}
+	)
+
+	for rows.Next() {
+		if err = rows.Scan(dest...); err != nil {
+			return list, errors.Join(err, rows.Err(), rows.Close())
+		}
+
+		var p Parent
+
+		for _, s := range parentScanners {
+			if s != nil {
+				if err = s(&p); err != nil {
+					return list, errors.Join(err, rows.Err(), rows.Close())
+				}
+			}
+		}
+
+		k := key(&p)
+
+		i, ok := index[k]
+		if !ok {
+			i = len(list)
+			index[k] = i
+
+			list = append(list, p)
+		}
+
+		var c Child
+
+		for _, s := range childScanners {
+			if s != nil {
+				if err = s(&c); err != nil {
+					return list, errors.Join(err, rows.Err(), rows.Close())
+				}
+			}
+		}
+
+		if childKey(&c) != nil {
+			appendChild(&list[i], c)
+		}
+	}
+
+	return list, errors.Join(rows.Err(), rows.Close())
+}
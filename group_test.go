@@ -0,0 +1,150 @@
+//nolint:gocritic,depguard,gochecknoglobals,exhaustivestruct,exhaustruct
+package scan_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/wroge/scan"
+)
+
+func joinRows() *fakeRows {
+	return &fakeRows{
+		index:   -1,
+		columns: []string{"id", "title", "author_id", "author_name"},
+		data: [][]any{
+			// A parent with no matching child comes first: the fakeRows
+			// fixture only writes a dest when the driver value's type
+			// matches, so a NULL column after a non-NULL one of the same
+			// column would otherwise leave the previous row's value in
+			// place rather than resetting it.
+			{3, "Post Three", nil, nil},
+			{1, "Post One", 1, "Jim"},
+			{1, "Post One", 2, "Tim"},
+			{2, "Post Two", 2, "Tim"},
+		},
+	}
+}
+
+//nolint:goerr113
+func joinColumnsErrRows() *fakeRows {
+	return &fakeRows{
+		index:      -1,
+		columnsErr: fmt.Errorf("columns error"),
+		columns:    []string{"id", "title", "author_id", "author_name"},
+		data:       [][]any{},
+	}
+}
+
+//nolint:goerr113
+func joinScanErrRows() *fakeRows {
+	return &fakeRows{
+		index:   -1,
+		scanErr: fmt.Errorf("scan error"),
+		columns: []string{"id", "title", "author_id", "author_name"},
+		data: [][]any{
+			{1, "Post One", 1, "Jim"},
+		},
+	}
+}
+
+var groupParentColumns = scan.Columns[Post]{
+	"id":    scan.Any(func(post *Post, id int64) { post.ID = id }),
+	"title": scan.Any(func(post *Post, title string) { post.Title = title }),
+}
+
+var groupChildColumns = scan.Columns[Author]{
+	"author_id":   scan.Any(func(author *Author, id int64) { author.ID = id }),
+	"author_name": scan.Any(func(author *Author, name string) { author.Name = name }),
+}
+
+func groupKey(post *Post) any {
+	return post.ID
+}
+
+func groupChildKey(author *Author) any {
+	if author.ID == 0 {
+		return nil
+	}
+
+	return author.ID
+}
+
+func groupAppendChild(post *Post, author Author) {
+	post.Authors = append(post.Authors, author)
+}
+
+func TestGroup(t *testing.T) {
+	t.Parallel()
+
+	posts, err := scan.Group[Post, Author](
+		joinRows(), groupKey, groupChildKey, groupParentColumns, groupChildColumns, groupAppendChild,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if fmt.Sprint(posts) != `[{3 Post Three []} {1 Post One [{1 Jim} {2 Tim}]} {2 Post Two [{2 Tim}]}]` {
+		t.Fatal(posts)
+	}
+}
+
+func TestGroupColumnsError(t *testing.T) {
+	t.Parallel()
+
+	_, err := scan.Group[Post, Author](
+		joinColumnsErrRows(), groupKey, groupChildKey, groupParentColumns, groupChildColumns, groupAppendChild,
+	)
+	if err == nil {
+		t.Fatal("error is nil")
+	}
+}
+
+func TestGroupScanError(t *testing.T) {
+	t.Parallel()
+
+	_, err := scan.Group[Post, Author](
+		joinScanErrRows(), groupKey, groupChildKey, groupParentColumns, groupChildColumns, groupAppendChild,
+	)
+	if err == nil {
+		t.Fatal("error is nil")
+	}
+}
+
+//nolint:goerr113
+func TestGroupParentScannerError(t *testing.T) {
+	t.Parallel()
+
+	failingParentColumns := scan.Columns[Post]{
+		"id": scan.Func[Post, int64](func(post *Post, id int64) error {
+			return fmt.Errorf("parent scanner error")
+		}),
+		"title": scan.Any(func(post *Post, title string) { post.Title = title }),
+	}
+
+	_, err := scan.Group[Post, Author](
+		joinRows(), groupKey, groupChildKey, failingParentColumns, groupChildColumns, groupAppendChild,
+	)
+	if err == nil {
+		t.Fatal("error is nil")
+	}
+}
+
+//nolint:goerr113
+func TestGroupChildScannerError(t *testing.T) {
+	t.Parallel()
+
+	failingChildColumns := scan.Columns[Author]{
+		"author_id": scan.Func[Author, int64](func(author *Author, id int64) error {
+			return fmt.Errorf("child scanner error")
+		}),
+		"author_name": scan.Any(func(author *Author, name string) { author.Name = name }),
+	}
+
+	_, err := scan.Group[Post, Author](
+		joinRows(), groupKey, groupChildKey, groupParentColumns, failingChildColumns, groupAppendChild,
+	)
+	if err == nil {
+		t.Fatal("error is nil")
+	}
+}
@@ -0,0 +1,42 @@
+package scan
+
+import "iter"
+
+// Seq returns an iter.Seq2 that yields the iterator's rows as (T, error)
+// pairs, so callers can write `for t, err := range iter.Seq() { ... }`. The
+// underlying Rows is closed once the sequence ends, whether by exhaustion,
+// an error, or an early break.
+func (i Iterator[T]) Seq() iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		defer i.Close()
+
+		for i.Next() {
+			t, err := i.Value()
+			if !yield(t, err) {
+				return
+			}
+
+			if err != nil {
+				return
+			}
+		}
+
+		if err := i.Err(); err != nil {
+			yield(*new(T), err)
+		}
+	}
+}
+
+// Range creates an iterator over rows and returns its Seq, so callers can
+// write `for t, err := range scan.Range[T](rows, columns) { ... }` instead of
+// managing Iter/Next/Scan/Close by hand.
+func Range[T any](rows Rows, columns Columns[T]) iter.Seq2[T, error] {
+	it, err := Iter(rows, columns)
+	if err != nil {
+		return func(yield func(T, error) bool) {
+			yield(*new(T), err)
+		}
+	}
+
+	return it.Seq()
+}
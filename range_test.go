@@ -0,0 +1,57 @@
+//nolint:gocritic,depguard,gochecknoglobals,exhaustivestruct,exhaustruct
+package scan_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/wroge/scan"
+)
+
+func TestRange(t *testing.T) {
+	t.Parallel()
+
+	var posts []Post
+
+	for post, err := range scan.Range[Post](rows1(), columns1) {
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		posts = append(posts, post)
+	}
+
+	if fmt.Sprint(posts) != `[{1 No Title [{1 Jim} {2 Tim}]} {2 Post Two [{2 Tim}]}`+
+		` {3 Post Three [{2 Tim} {3 Tom}]} {4 Post Four [{1 Jim} {2 Tim}]}`+
+		` {5 Post Five [{1 Jim} {3 Tom}]} {6 Post Six [{2 Tim}]} {7 Post Seven [{3 Tom}]}`+
+		` {8 Post Eight [{1 Jim}]} {9 Post Nine [{1 Jim} {2 Tim} {3 Tom}]} {10 Post Ten [{3 Tom}]}]` {
+		t.Fatal(posts)
+	}
+}
+
+func TestRangeBreak(t *testing.T) {
+	t.Parallel()
+
+	it, err := scan.Iter[Post](rows1(), columns1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count := 0
+
+	for range it.Seq() {
+		count++
+
+		if count == 2 {
+			break
+		}
+	}
+
+	if count != 2 {
+		t.Fatal(count)
+	}
+
+	if err = it.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -4,6 +4,7 @@
 package scan
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 )
@@ -123,59 +124,17 @@ func One[T any](rows Rows, columns Columns[T]) (T, error) {
 }
 
 // All retrieves all rows, scans them into a slice, and closes the iterator.
+// It is a thin wrapper around AllContext with context.Background(), kept for
+// callers that don't need cancellation.
 func All[T any](rows Rows, columns Columns[T]) ([]T, error) {
-	iter, err := Iter(rows, columns)
-	if err != nil {
-		return nil, err
-	}
-
-	var (
-		index = 0
-		list  []T
-	)
-
-	for iter.Next() {
-		list = append(list, *new(T))
-
-		if err = iter.Scan(&list[index]); err != nil {
-			return list, errors.Join(err, iter.Err(), iter.Close())
-		}
-
-		index++
-	}
-
-	return list, errors.Join(iter.Err(), iter.Close())
+	return AllContext(context.Background(), rows, columns)
 }
 
-// Limit retrieves up to a specified number of rows, scans them, and closes the iterator.
+// Limit retrieves up to a specified number of rows, scans them, and closes
+// the iterator. It is a thin wrapper around LimitContext with
+// context.Background(), kept for callers that don't need cancellation.
 func Limit[T any](limit int, rows Rows, columns Columns[T]) ([]T, error) {
-	iter, err := Iter(rows, columns)
-	if err != nil {
-		return nil, err
-	}
-
-	var (
-		index = 0
-		list  = make([]T, limit)
-	)
-
-	for iter.Next() {
-		if index >= limit {
-			return list, errors.Join(ErrTooManyRows, iter.Err(), iter.Close())
-		}
-
-		if err = iter.Scan(&list[index]); err != nil {
-			return list, errors.Join(err, iter.Err(), iter.Close())
-		}
-
-		index++
-	}
-
-	if index < limit {
-		list = list[:index]
-	}
-
-	return list, errors.Join(iter.Err(), iter.Close())
+	return LimitContext(context.Background(), limit, rows, columns)
 }
 
 // Iter creates a new iterator.
@@ -202,6 +161,7 @@ func Iter[T any](rows Rows, columns Columns[T]) (Iterator[T], error) {
 		rows:     rows,
 		dest:     dest,
 		scanners: scanners,
+		closed:   new(bool),
 	}, nil
 }
 
@@ -210,10 +170,21 @@ type Iterator[T any] struct {
 	rows     Rows
 	dest     []any
 	scanners []func(*T) error
+	closed   *bool
 }
 
-// Close releases resources of the iterator.
+// Close releases resources of the iterator. Close is idempotent: calling it
+// more than once, e.g. after a range-over-func loop already closed the
+// iterator on exit, only closes the underlying Rows once.
 func (i Iterator[T]) Close() error {
+	if i.closed != nil {
+		if *i.closed {
+			return nil
+		}
+
+		*i.closed = true
+	}
+
 	return i.rows.Close()
 }
 
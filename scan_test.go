@@ -333,6 +333,8 @@ func (r *fakeRows) Scan(dest ...any) error {
 
 	for index, d := range dest {
 		switch value := d.(type) {
+		case *any:
+			*value = r.data[r.index][index]
 		case *[]byte:
 			switch s := r.data[r.index][index].(type) {
 			case []byte: